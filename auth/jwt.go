@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// tokenTTL はトークンの有効期限です。
+const tokenTTL = 24 * time.Hour
+
+// ErrInvalidToken はトークンの検証に失敗した場合に返されます。
+var ErrInvalidToken = errors.New("invalid or expired token")
+
+// Init は JWT_SECRET が設定されていることを検証します。空の場合、署名鍵が
+// 空文字列になり誰でもトークンを偽造できてしまうため、起動時に fail-fast します。
+func Init() error {
+	if os.Getenv("JWT_SECRET") == "" {
+		return fmt.Errorf("JWT_SECRET must be set")
+	}
+
+	return nil
+}
+
+// Claims はアクセストークンに含める独自クレームです。
+type Claims struct {
+	UserID int    `json:"user_id"`
+	Role   string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+func signingKey() []byte {
+	return []byte(os.Getenv("JWT_SECRET"))
+}
+
+// GenerateToken は指定したユーザー情報を含む署名付き JWT を発行します。
+func GenerateToken(userID int, role string) (string, error) {
+	claims := Claims{
+		UserID: userID,
+		Role:   role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.Itoa(userID),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(tokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(signingKey())
+}
+
+// ParseToken は JWT を検証し、含まれるクレームを返します。
+func ParseToken(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrInvalidToken
+		}
+		return signingKey(), nil
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
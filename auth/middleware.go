@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/t-shimpo/go-rest-standard-library/httpresponse"
+)
+
+type contextKey string
+
+const (
+	userIDKey contextKey = "userID"
+	roleKey   contextKey = "role"
+)
+
+// Middleware は Authorization: Bearer <token> ヘッダーを検証し、
+// 認証済みユーザーの ID と role をリクエストコンテキストに格納します。
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		if !strings.HasPrefix(header, "Bearer ") {
+			httpresponse.Error(w, http.StatusUnauthorized, "認証トークンが必要です")
+			return
+		}
+
+		tokenString := strings.TrimPrefix(header, "Bearer ")
+		claims, err := ParseToken(tokenString)
+		if err != nil {
+			httpresponse.Error(w, http.StatusUnauthorized, "認証トークンが無効です")
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), userIDKey, claims.UserID)
+		ctx = context.WithValue(ctx, roleKey, claims.Role)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// UserIDFromContext はリクエストコンテキストから認証済みユーザーの ID を取り出します。
+func UserIDFromContext(ctx context.Context) (int, bool) {
+	id, ok := ctx.Value(userIDKey).(int)
+	return id, ok
+}
+
+// RoleFromContext はリクエストコンテキストから認証済みユーザーの role を取り出します。
+func RoleFromContext(ctx context.Context) (string, bool) {
+	role, ok := ctx.Value(roleKey).(string)
+	return role, ok
+}
+
+// IsSelfOrAdmin は認証済みユーザーが対象ユーザー本人か admin かを判定します。
+func IsSelfOrAdmin(ctx context.Context, targetID int) bool {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return false
+	}
+	if userID == targetID {
+		return true
+	}
+
+	role, _ := RoleFromContext(ctx)
+	return role == "admin"
+}
@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// Cursor は不透明なページネーションカーソルの中身です。
+type Cursor struct {
+	LastID    int    `json:"last_id"`
+	Direction string `json:"direction"`
+}
+
+// encodeCursor は Cursor を base64 の不透明な文字列にエンコードします。
+func encodeCursor(c Cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.URLEncoding.EncodeToString(b)
+}
+
+// decodeCursor は encodeCursor が生成した文字列を Cursor に戻します。
+func decodeCursor(s string) (Cursor, error) {
+	var c Cursor
+
+	b, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+// Links は HAL-lite 形式のナビゲーションリンクです。
+type Links struct {
+	Self string `json:"self"`
+	Next string `json:"next,omitempty"`
+}
+
+// Meta はレスポンスに含まれるメタ情報です。
+type Meta struct {
+	Count int `json:"count"`
+}
+
+// UsersResponse は GET /users のレスポンスボディです。
+type UsersResponse struct {
+	Data  interface{} `json:"data"`
+	Links Links       `json:"links"`
+	Meta  Meta        `json:"meta"`
+}
@@ -0,0 +1,22 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/t-shimpo/go-rest-standard-library/httpresponse"
+)
+
+func respondWithJson(w http.ResponseWriter, status int, data interface{}) {
+	httpresponse.JSON(w, status, data)
+}
+
+// respondWithError はエラーレスポンスを返します。err が非 nil の場合は
+// クライアントに見せるメッセージとは別にサーバー側でその内容をログに記録します。
+func respondWithError(w http.ResponseWriter, status int, message string, err error) {
+	if err != nil {
+		slog.Error(message, slog.String("error", err.Error()), slog.Int("status", status))
+	}
+
+	httpresponse.Error(w, status, message)
+}
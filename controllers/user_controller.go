@@ -0,0 +1,250 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/t-shimpo/go-rest-standard-library/auth"
+	"github.com/t-shimpo/go-rest-standard-library/models"
+	"github.com/t-shimpo/go-rest-standard-library/router"
+	"github.com/t-shimpo/go-rest-standard-library/services"
+)
+
+type RegisterRequest struct {
+	Name     string `json:"name" validate:"required,min=1,max=100"`
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,min=8"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type LoginResponse struct {
+	Token string `json:"token"`
+}
+
+type UpdateUserRequest struct {
+	Name  *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Email *string `json:"email,omitempty" validate:"omitempty,email"`
+}
+
+// UserController は UserService に依存する HTTP ハンドラー群です。
+type UserController struct {
+	service services.UserService
+}
+
+// NewUserController は UserService を注入した UserController を生成します。
+func NewUserController(service services.UserService) *UserController {
+	return &UserController{service: service}
+}
+
+// `POST /register`
+func (c *UserController) Register(w http.ResponseWriter, r *http.Request) {
+	var req RegisterRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	user, err := c.service.Register(req.Name, req.Email, req.Password)
+	if err != nil {
+		if errors.Is(err, services.ErrEmailTaken) {
+			respondWithError(w, http.StatusConflict, "このメールアドレスは既に登録されています", nil)
+			return
+		}
+		respondWithError(w, http.StatusInternalServerError, "ユーザー作成中にエラーが発生しました", err)
+		return
+	}
+
+	respondWithJson(w, http.StatusCreated, user)
+}
+
+// `POST /login`
+func (c *UserController) Login(w http.ResponseWriter, r *http.Request) {
+	var req LoginRequest
+	defer r.Body.Close()
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "無効なリクエストボディ", err)
+		return
+	}
+
+	user, err := c.service.Authenticate(req.Email, req.Password)
+	if err != nil {
+		respondWithError(w, http.StatusUnauthorized, "メールアドレスまたはパスワードが正しくありません", err)
+		return
+	}
+
+	token, err := auth.GenerateToken(user.ID, user.Role)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, "トークン発行中にエラーが発生しました", err)
+		return
+	}
+
+	respondWithJson(w, http.StatusOK, LoginResponse{Token: token})
+}
+
+// `GET /users`
+//
+// ?cursor= が指定されていればカーソルページネーション、
+// ?offset= のみが指定されていれば後方互換のオフセットページネーションを行います
+// (この場合 Deprecation ヘッダーを返します)。
+func (c *UserController) GetUsers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil {
+		limit = services.DefaultLimit
+	}
+	limit = services.ClampLimit(limit)
+
+	var users []models.User
+
+	switch {
+	case query.Get("cursor") != "":
+		cursor, err := decodeCursor(query.Get("cursor"))
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "カーソルが不正です", err)
+			return
+		}
+
+		users, err = c.service.ListAfter(limit, cursor.LastID)
+		if err != nil {
+			respondWithError(w, http.StatusInternalServerError, "ユーザー取得中にエラーが発生しました", err)
+			return
+		}
+
+	case query.Get("offset") != "":
+		offset, err := strconv.Atoi(query.Get("offset"))
+		if err != nil {
+			offset = 0
+		}
+
+		users, err = c.service.List(limit, offset)
+		if err != nil {
+			respondWithError(w, http.StatusInternalServerError, "ユーザー取得中にエラーが発生しました", err)
+			return
+		}
+
+		w.Header().Set("Deprecation", "true")
+
+	default:
+		users, err = c.service.ListAfter(limit, 0)
+		if err != nil {
+			respondWithError(w, http.StatusInternalServerError, "ユーザー取得中にエラーが発生しました", err)
+			return
+		}
+	}
+
+	resp := UsersResponse{
+		Data:  users,
+		Links: Links{Self: r.URL.RequestURI()},
+		Meta:  Meta{Count: len(users)},
+	}
+
+	if len(users) == limit {
+		next := encodeCursor(Cursor{LastID: users[len(users)-1].ID, Direction: "next"})
+		resp.Links.Next = fmt.Sprintf("/users?cursor=%s&limit=%d", next, limit)
+	}
+
+	respondWithJson(w, http.StatusOK, resp)
+}
+
+// `GET /users/{id}`
+func (c *UserController) GetUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := c.service.Get(id)
+	if err != nil {
+		respondWithNotFoundOrError(w, err, "ユーザー取得中にエラーが発生しました")
+		return
+	}
+
+	respondWithJson(w, http.StatusOK, user)
+}
+
+// `PATCH /users/{id}`
+func (c *UserController) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	if !auth.IsSelfOrAdmin(r.Context(), id) {
+		respondWithError(w, http.StatusForbidden, "このユーザーを更新する権限がありません", nil)
+		return
+	}
+
+	var req UpdateUserRequest
+	if err := decodeAndValidate(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	if req.Name == nil && req.Email == nil {
+		respondWithError(w, http.StatusBadRequest, "更新するフィールドを指定してください", nil)
+		return
+	}
+
+	user, err := c.service.Update(id, req.Name, req.Email)
+	if err != nil {
+		respondWithNotFoundOrError(w, err, "ユーザー更新中にエラーが発生しました")
+		return
+	}
+
+	respondWithJson(w, http.StatusOK, user)
+}
+
+// `DELETE /users/{id}`
+func (c *UserController) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	if !auth.IsSelfOrAdmin(r.Context(), id) {
+		respondWithError(w, http.StatusForbidden, "このユーザーを削除する権限がありません", nil)
+		return
+	}
+
+	if err := c.service.Delete(id); err != nil {
+		respondWithNotFoundOrError(w, err, "ユーザー削除中にエラーが発生しました")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseIDFromPath(w http.ResponseWriter, r *http.Request) (int, bool) {
+	idStr := router.PathParam(r, "id")
+	if idStr == "" {
+		respondWithError(w, http.StatusBadRequest, "IDが必要です", nil)
+		return 0, false
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "IDは数値である必要があります", err)
+		return 0, false
+	}
+
+	return id, true
+}
+
+func respondWithNotFoundOrError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, sql.ErrNoRows) {
+		respondWithError(w, http.StatusNotFound, "ユーザーが見つかりません", nil)
+		return
+	}
+
+	respondWithError(w, http.StatusInternalServerError, message, err)
+}
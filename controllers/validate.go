@@ -0,0 +1,110 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var validate = validator.New()
+
+func init() {
+	// Go のフィールド名ではなく json タグ名をフィールド名として使う
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+}
+
+// FieldError は 1 フィールド分のバリデーションエラーを表します。
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationErrorResponse は validation_failed レスポンスのボディです。
+type ValidationErrorResponse struct {
+	Error  string       `json:"error"`
+	Fields []FieldError `json:"fields"`
+}
+
+// ValidationError は decodeAndValidate がバリデーション失敗時に返すエラーです。
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	return "validation failed"
+}
+
+// decodeAndValidate はリクエストボディを dst にデコードし、構造体タグに基づいて検証します。
+func decodeAndValidate(r *http.Request, dst interface{}) error {
+	defer r.Body.Close()
+
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		return err
+	}
+
+	if err := validate.Struct(dst); err != nil {
+		verrs, ok := err.(validator.ValidationErrors)
+		if !ok {
+			return err
+		}
+
+		return &ValidationError{Fields: toFieldErrors(verrs)}
+	}
+
+	return nil
+}
+
+func toFieldErrors(verrs validator.ValidationErrors) []FieldError {
+	fields := make([]FieldError, 0, len(verrs))
+	for _, fe := range verrs {
+		fields = append(fields, FieldError{
+			Field:   fe.Field(),
+			Message: fieldMessage(fe),
+		})
+	}
+
+	return fields
+}
+
+func fieldMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return fmt.Sprintf("%s is required", fe.Field())
+	case "email":
+		return "invalid email"
+	case "min":
+		return fmt.Sprintf("%s must be at least %s characters", fe.Field(), fe.Param())
+	case "max":
+		return fmt.Sprintf("%s must be at most %s characters", fe.Field(), fe.Param())
+	default:
+		return fmt.Sprintf("%s is invalid", fe.Field())
+	}
+}
+
+// respondWithValidationError は validation_failed レスポンスを返します。
+func respondWithValidationError(w http.ResponseWriter, fields []FieldError) {
+	respondWithJson(w, http.StatusBadRequest, ValidationErrorResponse{
+		Error:  "validation_failed",
+		Fields: fields,
+	})
+}
+
+// respondDecodeError は decodeAndValidate のエラーをレスポンスに変換します。
+func respondDecodeError(w http.ResponseWriter, err error) {
+	if verr, ok := err.(*ValidationError); ok {
+		respondWithValidationError(w, verr.Fields)
+		return
+	}
+
+	respondWithError(w, http.StatusBadRequest, "無効なリクエストボディ", err)
+}
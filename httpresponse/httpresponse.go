@@ -0,0 +1,21 @@
+// Package httpresponse provides the shared {"error": "..."} JSON envelope
+// used across the API (controllers, router, auth, middleware) so every
+// failure mode returns a consistently-shaped, parseable body.
+package httpresponse
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// JSON writes data as a JSON response body with the given status code.
+func JSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+// Error writes {"error": message} as a JSON response body.
+func Error(w http.ResponseWriter, status int, message string) {
+	JSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"github.com/t-shimpo/go-rest-standard-library/auth"
+	"github.com/t-shimpo/go-rest-standard-library/controllers"
+	"github.com/t-shimpo/go-rest-standard-library/middleware"
+	"github.com/t-shimpo/go-rest-standard-library/models"
+	"github.com/t-shimpo/go-rest-standard-library/repositories"
+	"github.com/t-shimpo/go-rest-standard-library/router"
+	"github.com/t-shimpo/go-rest-standard-library/services"
+)
+
+func main() {
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+	if err := models.Init(os.Getenv("DATABASE_URL")); err != nil {
+		log.Fatalf("failed to initialize db: %v", err)
+	}
+
+	if err := auth.Init(); err != nil {
+		log.Fatalf("failed to initialize auth: %v", err)
+	}
+
+	userRepo := repositories.NewUserRepository(models.DB)
+	userService := services.NewUserService(userRepo)
+	userController := controllers.NewUserController(userService)
+
+	rt := router.New()
+
+	rt.POST("/register", public(userController.Register))
+	rt.POST("/login", public(userController.Login))
+
+	rt.GET("/users", protected(userController.GetUsers))
+	rt.GET("/users/{id}", protected(userController.GetUser))
+	rt.PATCH("/users/{id}", protected(userController.UpdateUser))
+	rt.DELETE("/users/{id}", protected(userController.DeleteUser))
+
+	log.Println("listening on :8080")
+	if err := http.ListenAndServe(":8080", rt); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// public は認証不要のエンドポイント向けのミドルウェアチェーンです。
+func public(handler http.HandlerFunc) http.HandlerFunc {
+	return middleware.RequestID(middleware.Logging(middleware.Recover(handler))).ServeHTTP
+}
+
+// protected は認証必須のエンドポイント向けのミドルウェアチェーンです。
+// Logging/Recover を auth.Middleware より外側に置くことで、トークンが
+// 無効/期限切れで 401 を返すリクエストも含めて全てログに記録されるように
+// しています (user_id はハンドラー側のコンテキストにしか乗らないため、
+// このチェーンではログに含まれません)。
+func protected(handler http.HandlerFunc) http.HandlerFunc {
+	return middleware.RequestID(middleware.Logging(middleware.Recover(auth.Middleware(handler)))).ServeHTTP
+}
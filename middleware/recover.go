@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/t-shimpo/go-rest-standard-library/httpresponse"
+)
+
+// Recover はハンドラー内の panic を回収し、スタックトレースをログに
+// 記録したうえで 500 Internal Server Error を返します。
+func Recover(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				slog.Error("panic recovered",
+					slog.Any("error", rec),
+					slog.String("stack", string(debug.Stack())),
+					slog.String("request_id", RequestIDFromContext(r.Context())),
+				)
+				httpresponse.Error(w, http.StatusInternalServerError, "Internal Server Error")
+			}
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
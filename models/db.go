@@ -0,0 +1,26 @@
+package models
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "github.com/lib/pq"
+)
+
+// DB はアプリケーション全体で共有する DB コネクションです。
+var DB *sql.DB
+
+// Init は DSN を使って DB に接続し、パッケージ変数 DB を初期化します。
+func Init(dsn string) error {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return fmt.Errorf("failed to open db: %w", err)
+	}
+
+	if err := db.Ping(); err != nil {
+		return fmt.Errorf("failed to ping db: %w", err)
+	}
+
+	DB = db
+	return nil
+}
@@ -0,0 +1,16 @@
+package models
+
+// User はユーザーテーブルの 1 レコードを表します。
+type User struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	PasswordHash string `json:"-"`
+	Role         string `json:"role"`
+}
+
+// Role が取り得る値。
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"database/sql"
+
+	"github.com/t-shimpo/go-rest-standard-library/models"
+)
+
+// UserRepository は users テーブルへの永続化操作を抽象化します。
+type UserRepository interface {
+	Create(user *models.User) (*models.User, error)
+	FindAll(limit, offset int) ([]models.User, error)
+	FindAfter(limit, afterID int) ([]models.User, error)
+	FindByID(id int) (*models.User, error)
+	FindByEmail(email string) (*models.User, error)
+	Update(id int, name, email *string) (*models.User, error)
+	Delete(id int) error
+}
+
+type userRepository struct {
+	db *sql.DB
+}
+
+// NewUserRepository は *sql.DB を使う UserRepository を生成します。
+func NewUserRepository(db *sql.DB) UserRepository {
+	return &userRepository{db: db}
+}
+
+func (r *userRepository) Create(user *models.User) (*models.User, error) {
+	err := r.db.QueryRow(
+		`INSERT INTO users (name, email, password_hash, role) VALUES ($1, $2, $3, $4) RETURNING id`,
+		user.Name, user.Email, user.PasswordHash, user.Role,
+	).Scan(&user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *userRepository) FindAll(limit, offset int) ([]models.User, error) {
+	rows, err := r.db.Query(
+		`SELECT id, name, email, password_hash, role FROM users ORDER BY id LIMIT $1 OFFSET $2`,
+		limit, offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []models.User{}
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.PasswordHash, &u.Role); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+
+	return users, rows.Err()
+}
+
+func (r *userRepository) FindAfter(limit, afterID int) ([]models.User, error) {
+	rows, err := r.db.Query(
+		`SELECT id, name, email, password_hash, role FROM users WHERE id > $1 ORDER BY id LIMIT $2`,
+		afterID, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []models.User{}
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.PasswordHash, &u.Role); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+
+	return users, rows.Err()
+}
+
+func (r *userRepository) FindByID(id int) (*models.User, error) {
+	var u models.User
+	err := r.db.QueryRow(
+		`SELECT id, name, email, password_hash, role FROM users WHERE id = $1`,
+		id,
+	).Scan(&u.ID, &u.Name, &u.Email, &u.PasswordHash, &u.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+func (r *userRepository) FindByEmail(email string) (*models.User, error) {
+	var u models.User
+	err := r.db.QueryRow(
+		`SELECT id, name, email, password_hash, role FROM users WHERE email = $1`,
+		email,
+	).Scan(&u.ID, &u.Name, &u.Email, &u.PasswordHash, &u.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+func (r *userRepository) Update(id int, name, email *string) (*models.User, error) {
+	u, err := r.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != nil {
+		u.Name = *name
+	}
+	if email != nil {
+		u.Email = *email
+	}
+
+	_, err = r.db.Exec(
+		`UPDATE users SET name = $1, email = $2 WHERE id = $3`,
+		u.Name, u.Email, id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+func (r *userRepository) Delete(id int) error {
+	if _, err := r.FindByID(id); err != nil {
+		return err
+	}
+
+	_, err := r.db.Exec(`DELETE FROM users WHERE id = $1`, id)
+	return err
+}
@@ -0,0 +1,119 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/t-shimpo/go-rest-standard-library/httpresponse"
+)
+
+type contextKey string
+
+const paramsKey contextKey = "pathParams"
+
+type route struct {
+	method   string
+	segments []string
+	handler  http.HandlerFunc
+}
+
+// Router は `{param}` 形式のパスパラメータとメソッドディスパッチをサポートする
+// 小さなルーターです。ルートは登録順に線形探索されます。
+type Router struct {
+	routes []route
+}
+
+// New は空の Router を生成します。
+func New() *Router {
+	return &Router{}
+}
+
+func (rt *Router) GET(pattern string, handler http.HandlerFunc) {
+	rt.Handle(http.MethodGet, pattern, handler)
+}
+
+func (rt *Router) POST(pattern string, handler http.HandlerFunc) {
+	rt.Handle(http.MethodPost, pattern, handler)
+}
+
+func (rt *Router) PATCH(pattern string, handler http.HandlerFunc) {
+	rt.Handle(http.MethodPatch, pattern, handler)
+}
+
+func (rt *Router) DELETE(pattern string, handler http.HandlerFunc) {
+	rt.Handle(http.MethodDelete, pattern, handler)
+}
+
+// Handle は任意のメソッドでパターンを登録します。
+func (rt *Router) Handle(method, pattern string, handler http.HandlerFunc) {
+	rt.routes = append(rt.routes, route{
+		method:   method,
+		segments: splitPath(pattern),
+		handler:  handler,
+	})
+}
+
+// ServeHTTP はパスにマッチするルートを探し、メソッドが一致しなければ
+// 405 Method Not Allowed を返します。
+func (rt *Router) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := splitPath(r.URL.Path)
+
+	pathMatched := false
+	for _, rte := range rt.routes {
+		params, ok := match(rte.segments, segments)
+		if !ok {
+			continue
+		}
+
+		pathMatched = true
+		if rte.method != r.Method {
+			continue
+		}
+
+		ctx := context.WithValue(r.Context(), paramsKey, params)
+		rte.handler(w, r.WithContext(ctx))
+		return
+	}
+
+	if pathMatched {
+		httpresponse.Error(w, http.StatusMethodNotAllowed, "許可されていないメソッドです")
+		return
+	}
+
+	httpresponse.Error(w, http.StatusNotFound, "リソースが見つかりません")
+}
+
+// PathParam は Router が抽出したパスパラメータを取得します。
+func PathParam(r *http.Request, name string) string {
+	params, _ := r.Context().Value(paramsKey).(map[string]string)
+	return params[name]
+}
+
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return []string{}
+	}
+
+	return strings.Split(path, "/")
+}
+
+func match(pattern, path []string) (map[string]string, bool) {
+	if len(pattern) != len(path) {
+		return nil, false
+	}
+
+	params := map[string]string{}
+	for i, seg := range pattern {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			params[strings.TrimSuffix(strings.TrimPrefix(seg, "{"), "}")] = path[i]
+			continue
+		}
+		if seg != path[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
@@ -0,0 +1,77 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRouterPathParam(t *testing.T) {
+	rt := New()
+
+	var gotID string
+	rt.GET("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		gotID = PathParam(r, "id")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	rec := httptest.NewRecorder()
+	rt.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if gotID != "42" {
+		t.Errorf("PathParam(id) = %q, want %q", gotID, "42")
+	}
+}
+
+func TestRouterNotFound(t *testing.T) {
+	rt := New()
+	rt.GET("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/unknown", nil)
+	rec := httptest.NewRecorder()
+	rt.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestRouterMethodNotAllowed(t *testing.T) {
+	rt := New()
+	rt.GET("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodDelete, "/users/42", nil)
+	rec := httptest.NewRecorder()
+	rt.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestRouterNotFoundAndMethodNotAllowedBodiesAreJSON(t *testing.T) {
+	rt := New()
+	rt.GET("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/unknown", nil),
+		httptest.NewRequest(http.MethodDelete, "/users/42", nil),
+	} {
+		rec := httptest.NewRecorder()
+		rt.ServeHTTP(rec, req)
+
+		if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Content-Type = %q, want application/json", ct)
+		}
+	}
+}
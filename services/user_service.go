@@ -0,0 +1,135 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/t-shimpo/go-rest-standard-library/auth"
+	"github.com/t-shimpo/go-rest-standard-library/models"
+	"github.com/t-shimpo/go-rest-standard-library/repositories"
+)
+
+// ページネーションのデフォルト値/上限値。controllers パッケージからも参照します。
+const (
+	DefaultLimit = 10
+	MaxLimit     = 100
+)
+
+// ClampLimit は limit を [1, MaxLimit] の範囲に丸めます。limit が 0 以下の
+// 場合は DefaultLimit を使います。controllers パッケージも、実際に DB へ
+// 渡る limit を事前に知るためにこれを呼び出します。
+func ClampLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	if limit > MaxLimit {
+		return MaxLimit
+	}
+	return limit
+}
+
+// ErrEmailTaken は既に登録済みのメールアドレスで登録しようとした場合に返されます。
+var ErrEmailTaken = errors.New("email is already registered")
+
+// ErrInvalidCredentials はログイン時にメールアドレスまたはパスワードが一致しない場合に返されます。
+var ErrInvalidCredentials = errors.New("invalid email or password")
+
+// UserService はユーザーに関するビジネスロジックを提供します。
+type UserService interface {
+	Register(name, email, password string) (*models.User, error)
+	Authenticate(email, password string) (*models.User, error)
+	List(limit, offset int) ([]models.User, error)
+	ListAfter(limit, afterID int) ([]models.User, error)
+	Get(id int) (*models.User, error)
+	Update(id int, name, email *string) (*models.User, error)
+	Delete(id int) error
+}
+
+type userService struct {
+	repo repositories.UserRepository
+}
+
+// NewUserService は UserRepository に依存する UserService を生成します。
+func NewUserService(repo repositories.UserRepository) UserService {
+	return &userService{repo: repo}
+}
+
+func (s *userService) Register(name, email, password string) (*models.User, error) {
+	name = strings.TrimSpace(name)
+	email = strings.TrimSpace(email)
+
+	_, err := s.repo.FindByEmail(email)
+	switch {
+	case err == nil:
+		return nil, ErrEmailTaken
+	case errors.Is(err, sql.ErrNoRows):
+		// メールアドレスは未登録 — 作成を続行する
+	default:
+		return nil, err
+	}
+
+	hash, err := auth.HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(&models.User{
+		Name:         name,
+		Email:        email,
+		PasswordHash: hash,
+		Role:         models.RoleUser,
+	})
+}
+
+func (s *userService) Authenticate(email, password string) (*models.User, error) {
+	user, err := s.repo.FindByEmail(email)
+	if err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	if err := auth.CheckPassword(user.PasswordHash, password); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	return user, nil
+}
+
+func (s *userService) List(limit, offset int) ([]models.User, error) {
+	limit = ClampLimit(limit)
+	if offset < 0 {
+		offset = 0
+	}
+
+	return s.repo.FindAll(limit, offset)
+}
+
+func (s *userService) ListAfter(limit, afterID int) ([]models.User, error) {
+	limit = ClampLimit(limit)
+	if afterID < 0 {
+		afterID = 0
+	}
+
+	return s.repo.FindAfter(limit, afterID)
+}
+
+func (s *userService) Get(id int) (*models.User, error) {
+	return s.repo.FindByID(id)
+}
+
+func (s *userService) Update(id int, name, email *string) (*models.User, error) {
+	if name != nil {
+		trimmed := strings.TrimSpace(*name)
+		name = &trimmed
+	}
+	if email != nil {
+		trimmed := strings.TrimSpace(*email)
+		email = &trimmed
+	}
+
+	return s.repo.Update(id, name, email)
+}
+
+func (s *userService) Delete(id int) error {
+	return s.repo.Delete(id)
+}
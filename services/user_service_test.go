@@ -0,0 +1,133 @@
+package services
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/t-shimpo/go-rest-standard-library/models"
+)
+
+// fakeUserRepository is an in-memory repositories.UserRepository used to
+// exercise UserService without a real DB.
+type fakeUserRepository struct {
+	usersByEmail map[string]*models.User
+
+	// findByEmailErr, when set, is returned by FindByEmail instead of
+	// sql.ErrNoRows — used to simulate a transient DB failure.
+	findByEmailErr error
+
+	// lastFindAllLimit/lastFindAfterLimit record the limit the service
+	// actually passed through, so tests can assert it was clamped.
+	lastFindAllLimit   int
+	lastFindAfterLimit int
+}
+
+func newFakeUserRepository() *fakeUserRepository {
+	return &fakeUserRepository{usersByEmail: map[string]*models.User{}}
+}
+
+func (r *fakeUserRepository) Create(user *models.User) (*models.User, error) {
+	r.usersByEmail[user.Email] = user
+	return user, nil
+}
+
+func (r *fakeUserRepository) FindAll(limit, offset int) ([]models.User, error) {
+	r.lastFindAllLimit = limit
+	return nil, nil
+}
+
+func (r *fakeUserRepository) FindAfter(limit, afterID int) ([]models.User, error) {
+	r.lastFindAfterLimit = limit
+	return nil, nil
+}
+
+func (r *fakeUserRepository) FindByID(id int) (*models.User, error) {
+	return nil, sql.ErrNoRows
+}
+
+func (r *fakeUserRepository) FindByEmail(email string) (*models.User, error) {
+	if r.findByEmailErr != nil {
+		return nil, r.findByEmailErr
+	}
+	if u, ok := r.usersByEmail[email]; ok {
+		return u, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (r *fakeUserRepository) Update(id int, name, email *string) (*models.User, error) {
+	return nil, sql.ErrNoRows
+}
+
+func (r *fakeUserRepository) Delete(id int) error {
+	return sql.ErrNoRows
+}
+
+func TestClampLimit(t *testing.T) {
+	cases := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{"zero uses default", 0, DefaultLimit},
+		{"negative uses default", -5, DefaultLimit},
+		{"within range is unchanged", 25, 25},
+		{"over max is capped", MaxLimit + 50, MaxLimit},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ClampLimit(tc.limit); got != tc.want {
+				t.Errorf("ClampLimit(%d) = %d, want %d", tc.limit, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUserServiceListClampsLimitBeforeQuerying(t *testing.T) {
+	repo := newFakeUserRepository()
+	svc := NewUserService(repo)
+
+	if _, err := svc.List(MaxLimit+50, 0); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if repo.lastFindAllLimit != MaxLimit {
+		t.Errorf("repo received limit %d, want %d", repo.lastFindAllLimit, MaxLimit)
+	}
+
+	if _, err := svc.ListAfter(0, 0); err != nil {
+		t.Fatalf("ListAfter returned error: %v", err)
+	}
+	if repo.lastFindAfterLimit != DefaultLimit {
+		t.Errorf("repo received limit %d, want %d", repo.lastFindAfterLimit, DefaultLimit)
+	}
+}
+
+func TestUserServiceRegisterRejectsDuplicateEmail(t *testing.T) {
+	repo := newFakeUserRepository()
+	repo.usersByEmail["taken@example.com"] = &models.User{Email: "taken@example.com"}
+	svc := NewUserService(repo)
+
+	if _, err := svc.Register("Name", "taken@example.com", "password123"); err != ErrEmailTaken {
+		t.Fatalf("Register error = %v, want %v", err, ErrEmailTaken)
+	}
+}
+
+func TestUserServiceRegisterPropagatesRepositoryError(t *testing.T) {
+	repo := newFakeUserRepository()
+	repo.findByEmailErr = sql.ErrConnDone
+	svc := NewUserService(repo)
+
+	if _, err := svc.Register("Name", "new@example.com", "password123"); err != sql.ErrConnDone {
+		t.Fatalf("Register error = %v, want %v", err, sql.ErrConnDone)
+	}
+}
+
+func TestUserServiceRegisterSucceedsWhenEmailIsFree(t *testing.T) {
+	repo := newFakeUserRepository()
+	svc := NewUserService(repo)
+
+	if _, err := svc.Register("Name", "new@example.com", "password123"); err != nil {
+		t.Fatalf("Register returned unexpected error: %v", err)
+	}
+}